@@ -0,0 +1,53 @@
+// Copyright © 2018,2021 Kaleido, Inc.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ethbinding
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// This module provides access to the abigen contract binding generator, so
+// callers of this plugin can generate Go/Java wrappers for Solidity contracts
+// without shelling out to the abigen binary. libs is passed to the generator
+// unchanged: each key is the 34 character hex pattern of a `__$pattern$__`
+// library placeholder in the bytecode, and each value is the type name of that
+// library. The generated deploy code deploys each linked library itself and
+// substitutes the resulting address for its placeholder; addresses of already
+// deployed libraries cannot be supplied, and old style `__LibName____`
+// placeholders are not matched.
+
+// BindLang is the target language for a generated contract binding
+type BindLang = bind.Lang
+
+const (
+	// BindLangGo generates a Go source file for the bound contracts
+	BindLangGo = bind.LangGo
+	// BindLangJava generates a Java source file for the bound contracts
+	BindLangJava = bind.LangJava
+)
+
+// BindContracts generates Go source for the supplied contracts, in the given
+// package, linking any libraries referenced in the bytecode
+func (e *ethAPIShim) BindContracts(types []string, abis []string, bytecodes []string, fsigs []map[string]string, pkg string, libs map[string]string) (string, error) {
+	return bind.Bind(types, abis, bytecodes, fsigs, pkg, bind.LangGo, libs, nil)
+}
+
+// BindContractsJava generates Java source for the supplied contracts, linking
+// any libraries referenced in the bytecode
+func (e *ethAPIShim) BindContractsJava(types []string, abis []string, bytecodes []string, fsigs []map[string]string, pkg string, libs map[string]string) (string, error) {
+	return bind.Bind(types, abis, bytecodes, fsigs, pkg, bind.LangJava, libs, nil)
+}
@@ -0,0 +1,84 @@
+// Copyright © 2018,2021 Kaleido, Inc.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ethbinding
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/external"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Signer aliases the go-ethereum transaction signer interface, so an
+// ExternalSigner can be dropped into existing SignTx flows
+type Signer = types.Signer
+
+// ExternalSigner lets operators keep private keys in a hardened external
+// signer (such as Clef) rather than handing an in-process *ecdsa.PrivateKey
+// into SignTx
+type ExternalSigner interface {
+	Signer
+	Accounts() ([]Address, error)
+	SignTx(account Address, tx *Transaction, chainID *big.Int) (*Transaction, error)
+	SignData(account Address, mimeType string, data []byte) ([]byte, error)
+}
+
+// externalSignerShim speaks the Clef external signer JSON-RPC protocol
+// (account_list, account_signTransaction, account_signData) over the
+// endpoint's HTTP/IPC transport. It embeds a plain Signer so that it also
+// satisfies types.Signer for use in existing transaction flows. The embedded
+// Signer is a London signer for chain ID 0: its ChainID() is 0, and Sender
+// fails with an invalid chain ID error for any transaction that carries a
+// non-zero chain ID.
+type externalSignerShim struct {
+	Signer
+	es *external.ExternalSigner
+}
+
+// NewExternalSigner dials a Clef-compatible external signer at endpoint
+func (e *ethAPIShim) NewExternalSigner(endpoint string) (ExternalSigner, error) {
+	es, err := external.NewExternalSigner(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return &externalSignerShim{
+		Signer: types.NewLondonSigner(new(big.Int)),
+		es:     es,
+	}, nil
+}
+
+// Accounts lists the accounts known to the external signer
+func (s *externalSignerShim) Accounts() ([]Address, error) {
+	accts := s.es.Accounts()
+	addrs := make([]Address, len(accts))
+	for i, a := range accts {
+		addrs[i] = a.Address
+	}
+	return addrs, nil
+}
+
+// SignTx asks the external signer to sign tx on behalf of account
+func (s *externalSignerShim) SignTx(account Address, tx *Transaction, chainID *big.Int) (*Transaction, error) {
+	return s.es.SignTx(accounts.Account{Address: account}, tx, chainID)
+}
+
+// SignData asks the external signer to sign an arbitrary data payload on
+// behalf of account
+func (s *externalSignerShim) SignData(account Address, mimeType string, data []byte) ([]byte, error) {
+	return s.es.SignData(accounts.Account{Address: account}, mimeType, data)
+}
@@ -0,0 +1,134 @@
+// Copyright © 2018,2021 Kaleido, Inc.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ethbinding
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// DecodedCall is the result of matching calldata against a registered 4-byte
+// function selector and unpacking its arguments
+type DecodedCall struct {
+	Signature string
+	Arguments []interface{}
+}
+
+// fourByteEntry is the parsed form of a registered canonical signature
+type fourByteEntry struct {
+	signature string
+	arguments ABIArguments
+}
+
+// Register4ByteDirectory registers canonical signatures (e.g.
+// "transfer(address,uint256)") against their 0x-prefixed 4-byte selectors,
+// for later lookup by DecodeCalldata
+func (e *ethAPIShim) Register4ByteDirectory(entries map[string]string) error {
+	e.fourByteMu.Lock()
+	defer e.fourByteMu.Unlock()
+	if e.fourByteDir == nil {
+		e.fourByteDir = make(map[string]fourByteEntry, len(entries))
+	}
+	for selector, signature := range entries {
+		args, err := parseFourByteArguments(signature)
+		if err != nil {
+			return fmt.Errorf("invalid signature '%s' for selector %s: %s", signature, selector, err)
+		}
+		e.fourByteDir[strings.ToLower(selector)] = fourByteEntry{signature: signature, arguments: args}
+	}
+	return nil
+}
+
+// LoadFourByteDirectoryFromJSON bulk loads the standard 4byte.json format
+// ({"0xa9059cbb": "transfer(address,uint256)", ...}) into the directory
+func (e *ethAPIShim) LoadFourByteDirectoryFromJSON(r io.Reader) error {
+	var entries map[string]string
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return err
+	}
+	return e.Register4ByteDirectory(entries)
+}
+
+// DecodeCalldata looks up the first 4 bytes of data in the registered
+// directory and unpacks the remaining calldata against the matched signature
+func (e *ethAPIShim) DecodeCalldata(data []byte) (*DecodedCall, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("calldata too short to contain a function selector")
+	}
+	selector := fmt.Sprintf("0x%x", data[:4])
+	e.fourByteMu.RLock()
+	entry, ok := e.fourByteDir[selector]
+	e.fourByteMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("no signature registered for selector %s", selector)
+	}
+	values, err := entry.arguments.UnpackValues(data[4:])
+	if err != nil {
+		return nil, err
+	}
+	return &DecodedCall{Signature: entry.signature, Arguments: values}, nil
+}
+
+// parseFourByteArguments parses the argument types out of a canonical
+// signature, e.g. "transfer(address,uint256)" -> [address, uint256]
+func parseFourByteArguments(signature string) (ABIArguments, error) {
+	open := strings.Index(signature, "(")
+	closeParen := strings.LastIndex(signature, ")")
+	if open < 0 || closeParen < open {
+		return nil, fmt.Errorf("expected a canonical signature of the form name(type,type,...)")
+	}
+	inner := signature[open+1 : closeParen]
+	if inner == "" {
+		return ABIArguments{}, nil
+	}
+	typeNames := splitTopLevelArgs(inner)
+	args := make(ABIArguments, len(typeNames))
+	for i, typeName := range typeNames {
+		t, err := abi.NewType(typeName, "", []abi.ArgumentMarshaling{})
+		if err != nil {
+			return nil, err
+		}
+		args[i].Type = t
+	}
+	return args, nil
+}
+
+// splitTopLevelArgs splits a comma separated argument type list, without
+// splitting inside nested tuple parentheses
+func splitTopLevelArgs(s string) []string {
+	var parts []string
+	depth := 0
+	last := 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, s[last:i])
+				last = i + 1
+			}
+		}
+	}
+	return append(parts, s[last:])
+}
@@ -0,0 +1,52 @@
+// Copyright © 2018,2021 Kaleido, Inc.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ethbinding
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// GenesisAlloc is the pre-funded account set a SimulatedBackend is seeded with
+type GenesisAlloc = core.GenesisAlloc
+
+// SimulatedBackend is an in-memory Ethereum chain, useful for unit testing
+// submit/dispatch paths and estimating gas without a running node
+type SimulatedBackend interface {
+	CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+	SendTransaction(ctx context.Context, tx *Transaction) error
+	Commit() common.Hash
+	Rollback()
+	AdjustTime(adjustment time.Duration) error
+	EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error)
+	FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error)
+	SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// NewSimulatedBackend creates a new in-memory chain seeded with alloc, for
+// offline contract testing and gas estimation
+func (e *ethAPIShim) NewSimulatedBackend(alloc GenesisAlloc, gasLimit uint64) SimulatedBackend {
+	return backends.NewSimulatedBackend(alloc, gasLimit)
+}
@@ -0,0 +1,51 @@
+// Copyright © 2018,2021 Kaleido, Inc.
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ethbinding
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// TypedData mirrors the EIP-712 typed data structure (domain separator,
+// primaryType, types map and message map) used for signing
+type TypedData = apitypes.TypedData
+
+// TypedDataHash computes the EIP-712 digest for typedData: it builds the
+// domain separator from the EIP712Domain fields, computes
+// hashStruct(primaryType, message), and returns
+// keccak256(0x19 || 0x01 || domainSeparator || hashStruct(message))
+func (e *ethAPIShim) TypedDataHash(typedData TypedData) (Hash, error) {
+	digest, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return Hash{}, err
+	}
+	return common.BytesToHash(digest), nil
+}
+
+// SignTypedData signs the EIP-712 digest of typedData with prv, returning the
+// 65-byte [R || S || V] signature
+func (e *ethAPIShim) SignTypedData(typedData TypedData, prv *ecdsa.PrivateKey) ([]byte, error) {
+	digest, err := e.TypedDataHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.Sign(digest.Bytes(), prv)
+}
@@ -23,6 +23,7 @@ import (
 	"io"
 	"math/big"
 	"strings"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -60,6 +61,13 @@ type EthAPI interface {
 	NewTransaction(nonce uint64, to common.Address, amount *big.Int, gasLimit uint64, gasPrice *big.Int, data []byte) *Transaction
 	NewContractCreation(nonce uint64, amount *big.Int, gasLimit uint64, gasPrice *big.Int, data []byte) *Transaction
 	NewEIP155Signer(chainID *big.Int) EIP155Signer
+	NewDynamicFeeTx(chainID *big.Int, nonce uint64, to *common.Address, amount *big.Int, gasLimit uint64, gasTipCap, gasFeeCap *big.Int, data []byte, accessList types.AccessList) *Transaction
+	NewAccessListTx(chainID *big.Int, nonce uint64, to *common.Address, amount *big.Int, gasLimit uint64, gasPrice *big.Int, data []byte, accessList types.AccessList) *Transaction
+	NewLondonSigner(chainID *big.Int) Signer
+	TxType(tx *Transaction) uint8
+	GasTipCap(tx *Transaction) *big.Int
+	GasFeeCap(tx *Transaction) *big.Int
+	AccessList(tx *Transaction) types.AccessList
 	ParseBig256(s string) (*big.Int, bool)
 	S256(x *big.Int) *big.Int
 	GenerateKey() (*ecdsa.PrivateKey, error)
@@ -68,12 +76,24 @@ type EthAPI interface {
 	HexToECDSA(hexkey string) (*ecdsa.PrivateKey, error)
 	NewStream(r io.Reader, inputLimit uint64) *rlp.Stream
 	SignTx(tx *types.Transaction, s types.Signer, prv *ecdsa.PrivateKey) (*types.Transaction, error)
+	BindContracts(types []string, abis []string, bytecodes []string, fsigs []map[string]string, pkg string, libs map[string]string) (string, error)
+	BindContractsJava(types []string, abis []string, bytecodes []string, fsigs []map[string]string, pkg string, libs map[string]string) (string, error)
+	NewSimulatedBackend(alloc GenesisAlloc, gasLimit uint64) SimulatedBackend
+	NewExternalSigner(endpoint string) (ExternalSigner, error)
+	TypedDataHash(typedData TypedData) (Hash, error)
+	SignTypedData(typedData TypedData, prv *ecdsa.PrivateKey) ([]byte, error)
+	Register4ByteDirectory(entries map[string]string) error
+	LoadFourByteDirectoryFromJSON(r io.Reader) error
+	DecodeCalldata(data []byte) (*DecodedCall, error)
 }
 
 // EthAPIShim is an implementation of the shim
 var EthAPIShim EthAPI = &ethAPIShim{}
 
-type ethAPIShim struct{}
+type ethAPIShim struct {
+	fourByteMu  sync.RWMutex
+	fourByteDir map[string]fourByteEntry
+}
 
 // This module provides access to some utils from the common package, with
 // type mapping
@@ -231,6 +251,64 @@ func (e *ethAPIShim) NewEIP155Signer(chainID *big.Int) EIP155Signer {
 	return types.NewEIP155Signer(chainID)
 }
 
+// NewDynamicFeeTx creates a new EIP-1559 type-2 transaction, with a tip and
+// fee cap in place of a single gas price, and an optional access list
+func (e *ethAPIShim) NewDynamicFeeTx(chainID *big.Int, nonce uint64, to *common.Address, amount *big.Int, gasLimit uint64, gasTipCap, gasFeeCap *big.Int, data []byte, accessList types.AccessList) *Transaction {
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:    chainID,
+		Nonce:      nonce,
+		To:         to,
+		Value:      amount,
+		Gas:        gasLimit,
+		GasTipCap:  gasTipCap,
+		GasFeeCap:  gasFeeCap,
+		Data:       data,
+		AccessList: accessList,
+	})
+}
+
+// NewAccessListTx creates a new EIP-2930 type-1 transaction, a legacy-priced
+// transaction with an attached access list
+func (e *ethAPIShim) NewAccessListTx(chainID *big.Int, nonce uint64, to *common.Address, amount *big.Int, gasLimit uint64, gasPrice *big.Int, data []byte, accessList types.AccessList) *Transaction {
+	return types.NewTx(&types.AccessListTx{
+		ChainID:    chainID,
+		Nonce:      nonce,
+		To:         to,
+		Value:      amount,
+		Gas:        gasLimit,
+		GasPrice:   gasPrice,
+		Data:       data,
+		AccessList: accessList,
+	})
+}
+
+// NewLondonSigner returns a Signer that accepts legacy, EIP-2930 and
+// EIP-1559 transactions, as introduced in the London hard fork
+func (e *ethAPIShim) NewLondonSigner(chainID *big.Int) Signer {
+	return types.NewLondonSigner(chainID)
+}
+
+// TxType returns the EIP-2718 transaction type: 0 for legacy, 1 for
+// EIP-2930 access list transactions, 2 for EIP-1559 dynamic fee transactions
+func (e *ethAPIShim) TxType(tx *Transaction) uint8 {
+	return tx.Type()
+}
+
+// GasTipCap returns the gas tip cap of a type-1/type-2 transaction
+func (e *ethAPIShim) GasTipCap(tx *Transaction) *big.Int {
+	return tx.GasTipCap()
+}
+
+// GasFeeCap returns the gas fee cap of a type-1/type-2 transaction
+func (e *ethAPIShim) GasFeeCap(tx *Transaction) *big.Int {
+	return tx.GasFeeCap()
+}
+
+// AccessList returns the access list of a type-1/type-2 transaction
+func (e *ethAPIShim) AccessList(tx *Transaction) types.AccessList {
+	return tx.AccessList()
+}
+
 func (e *ethAPIShim) ParseBig256(s string) (*big.Int, bool) {
 	return math.ParseBig256(s)
 }
@@ -262,7 +340,10 @@ func (e *ethAPIShim) NewStream(r io.Reader, inputLimit uint64) *rlp.Stream {
 	return rlp.NewStream(r, inputLimit)
 }
 
-// SignTx signs the transaction using the given signer and private key
+// SignTx signs the transaction using the given signer and private key. The
+// signer determines which transaction types are accepted: EIP155Signer only
+// signs legacy transactions, while a Signer returned from NewLondonSigner
+// also accepts EIP-2930 access list and EIP-1559 dynamic fee transactions
 func (e *ethAPIShim) SignTx(tx *types.Transaction, s types.Signer, prv *ecdsa.PrivateKey) (*types.Transaction, error) {
 	return types.SignTx(tx, s, prv)
 }